@@ -0,0 +1,147 @@
+package tokbox
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"golang.org/x/net/context"
+)
+
+// StreamMode determines whether streams included in an archive are selected
+// automatically or manually via AddArchiveStream/RemoveArchiveStream.
+type StreamMode string
+
+const (
+	StreamModeAuto   StreamMode = "auto"
+	StreamModeManual            = "manual"
+)
+
+// Archive represents the state of a recorded OpenTok session.
+// See documentation: https://tokbox.com/developer/rest/#archiving
+type Archive struct {
+	Id         string     `json:"id"`
+	Status     string     `json:"status"`
+	CreatedAt  int64      `json:"createdAt"`
+	Duration   int        `json:"duration"`
+	Reason     string     `json:"reason"`
+	Size       int64      `json:"size"`
+	Url        string     `json:"url"`
+	OutputMode OutputMode `json:"outputMode"`
+	Resolution string     `json:"resolution"`
+	HasAudio   bool       `json:"hasAudio"`
+	HasVideo   bool       `json:"hasVideo"`
+	StreamMode StreamMode `json:"streamMode"`
+}
+
+// Customizing the video layout for composed archives
+// See documentation: https://tokbox.com/developer/guides/archiving/layout-control.html
+func (t *Tokbox) StartArchive(sessionId string, name string, outputMode OutputMode, layout ArchiveLayout, ctx ...context.Context) error {
+	params := struct {
+		SessionId  string        `json:"sessionId"`
+		Layout     ArchiveLayout `json:"layout"`
+		Name       string        `json:"name"`
+		OutputMode OutputMode    `json:"outputMode"`
+	}{
+		SessionId:  sessionId,
+		Layout:     layout,
+		Name:       name,
+		OutputMode: outputMode,
+	}
+
+	return t.do(firstContext(ctx), http.MethodPost, apiArchive, params, nil)
+}
+
+// StopArchive stops an archive that is currently being recorded.
+func (t *Tokbox) StopArchive(archiveId string, ctx ...context.Context) error {
+	return t.do(firstContext(ctx), http.MethodPost, apiArchive+"/"+archiveId+"/stop", nil, nil)
+}
+
+// GetArchive retrieves the properties of a single archive.
+func (t *Tokbox) GetArchive(archiveId string, ctx ...context.Context) (*Archive, error) {
+	var a Archive
+	if err := t.do(firstContext(ctx), http.MethodGet, apiArchive+"/"+archiveId, nil, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ListArchives returns the archives for the project, optionally filtered by
+// sessionId and paginated with offset/count.
+func (t *Tokbox) ListArchives(offset, count int, sessionId string, ctx ...context.Context) ([]*Archive, error) {
+	path := apiArchive
+
+	q := url.Values{}
+	if offset > 0 {
+		q.Add("offset", strconv.Itoa(offset))
+	}
+	if count > 0 {
+		q.Add("count", strconv.Itoa(count))
+	}
+	if len(sessionId) > 0 {
+		q.Add("sessionId", sessionId)
+	}
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var list struct {
+		Items []*Archive `json:"items"`
+		Count int        `json:"count"`
+	}
+	if err := t.do(firstContext(ctx), http.MethodGet, path, nil, &list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// DeleteArchive deletes an archive and its recording.
+func (t *Tokbox) DeleteArchive(archiveId string, ctx ...context.Context) error {
+	return t.do(firstContext(ctx), http.MethodDelete, apiArchive+"/"+archiveId, nil, nil, http.StatusNoContent)
+}
+
+// UpdateArchiveLayout changes the layout type and style of a composed archive.
+func (t *Tokbox) UpdateArchiveLayout(archiveId string, layout ArchiveLayout, ctx ...context.Context) error {
+	return t.do(firstContext(ctx), http.MethodPut, apiArchive+"/"+archiveId+"/layout", layout, nil)
+}
+
+// SetArchiveStreamMode selects whether streams are added to an archive
+// automatically or manually via AddArchiveStream/RemoveArchiveStream.
+func (t *Tokbox) SetArchiveStreamMode(archiveId string, mode StreamMode, ctx ...context.Context) error {
+	params := struct {
+		StreamMode StreamMode `json:"streamMode"`
+	}{
+		StreamMode: mode,
+	}
+
+	return t.do(firstContext(ctx), http.MethodPut, apiArchive+"/"+archiveId+"/streammode", params, nil)
+}
+
+// AddArchiveStream adds a stream to an archive that is using StreamModeManual.
+func (t *Tokbox) AddArchiveStream(archiveId, streamId string, hasAudio, hasVideo bool, ctx ...context.Context) error {
+	return t.patchArchiveStream(firstContext(ctx), archiveId, streamId, &hasAudio, &hasVideo, false)
+}
+
+// RemoveArchiveStream removes a stream from an archive that is using StreamModeManual.
+func (t *Tokbox) RemoveArchiveStream(archiveId, streamId string, ctx ...context.Context) error {
+	return t.patchArchiveStream(firstContext(ctx), archiveId, streamId, nil, nil, true)
+}
+
+func (t *Tokbox) patchArchiveStream(ctx context.Context, archiveId, streamId string, hasAudio, hasVideo *bool, remove bool) error {
+	params := struct {
+		AddStream    string `json:"addStream,omitempty"`
+		RemoveStream string `json:"removeStream,omitempty"`
+		HasAudio     *bool  `json:"hasAudio,omitempty"`
+		HasVideo     *bool  `json:"hasVideo,omitempty"`
+	}{
+		HasAudio: hasAudio,
+		HasVideo: hasVideo,
+	}
+	if remove {
+		params.RemoveStream = streamId
+	} else {
+		params.AddStream = streamId
+	}
+
+	return t.do(ctx, http.MethodPatch, apiArchive+"/"+archiveId+"/streams", params, nil, http.StatusNoContent)
+}
@@ -0,0 +1,179 @@
+package tokbox
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestArchiveRequests(t *testing.T) {
+	cases := []struct {
+		name       string
+		call       func(tb *Tokbox) error
+		wantMethod string
+		wantPath   string
+		wantBody   map[string]interface{}
+		status     int
+	}{
+		{
+			name: "StartArchive",
+			call: func(tb *Tokbox) error {
+				return tb.StartArchive("sess1", "my-archive", Composed, ArchiveLayout{Type: BestFit})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v2/project/key/archive",
+			wantBody: map[string]interface{}{
+				"sessionId":  "sess1",
+				"name":       "my-archive",
+				"outputMode": "composed",
+				"layout":     map[string]interface{}{"type": "bestFit"},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "StopArchive",
+			call: func(tb *Tokbox) error {
+				return tb.StopArchive("arc1")
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v2/project/key/archive/arc1/stop",
+			status:     http.StatusOK,
+		},
+		{
+			name: "DeleteArchive",
+			call: func(tb *Tokbox) error {
+				return tb.DeleteArchive("arc1")
+			},
+			wantMethod: http.MethodDelete,
+			wantPath:   "/v2/project/key/archive/arc1",
+			status:     http.StatusNoContent,
+		},
+		{
+			name: "UpdateArchiveLayout",
+			call: func(tb *Tokbox) error {
+				return tb.UpdateArchiveLayout("arc1", ArchiveLayout{Type: Pip})
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/v2/project/key/archive/arc1/layout",
+			wantBody:   map[string]interface{}{"type": "pip"},
+			status:     http.StatusOK,
+		},
+		{
+			name: "SetArchiveStreamMode",
+			call: func(tb *Tokbox) error {
+				return tb.SetArchiveStreamMode("arc1", StreamModeManual)
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/v2/project/key/archive/arc1/streammode",
+			wantBody:   map[string]interface{}{"streamMode": "manual"},
+			status:     http.StatusOK,
+		},
+		{
+			name: "AddArchiveStream",
+			call: func(tb *Tokbox) error {
+				return tb.AddArchiveStream("arc1", "stream1", true, false)
+			},
+			wantMethod: http.MethodPatch,
+			wantPath:   "/v2/project/key/archive/arc1/streams",
+			wantBody: map[string]interface{}{
+				"addStream": "stream1",
+				"hasAudio":  true,
+				"hasVideo":  false,
+			},
+			status: http.StatusNoContent,
+		},
+		{
+			name: "RemoveArchiveStream",
+			call: func(tb *Tokbox) error {
+				return tb.RemoveArchiveStream("arc1", "stream1")
+			},
+			wantMethod: http.MethodPatch,
+			wantPath:   "/v2/project/key/archive/arc1/streams",
+			wantBody:   map[string]interface{}{"removeStream": "stream1"},
+			status:     http.StatusNoContent,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tb, req := captureRequest(t, c.status)
+			if err := c.call(tb); err != nil {
+				t.Fatalf("call returned error: %v", err)
+			}
+
+			if req.Method != c.wantMethod {
+				t.Errorf("method = %q, want %q", req.Method, c.wantMethod)
+			}
+			if req.Path != c.wantPath {
+				t.Errorf("path = %q, want %q", req.Path, c.wantPath)
+			}
+			assertJSONBody(t, req, c.wantBody)
+		})
+	}
+}
+
+func TestGetArchive(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusOK, `{
+		"id": "arc1",
+		"status": "available",
+		"duration": 42,
+		"outputMode": "composed",
+		"hasAudio": true,
+		"hasVideo": false,
+		"streamMode": "auto"
+	}`)
+
+	a, err := tb.GetArchive("arc1")
+	if err != nil {
+		t.Fatalf("GetArchive returned error: %v", err)
+	}
+
+	if req.Method != http.MethodGet {
+		t.Errorf("method = %q, want GET", req.Method)
+	}
+	if req.Path != "/v2/project/key/archive/arc1" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/archive/arc1")
+	}
+
+	want := &Archive{
+		Id:         "arc1",
+		Status:     "available",
+		Duration:   42,
+		OutputMode: Composed,
+		HasAudio:   true,
+		HasVideo:   false,
+		StreamMode: StreamModeAuto,
+	}
+	if *a != *want {
+		t.Errorf("GetArchive() = %+v, want %+v", a, want)
+	}
+}
+
+func TestListArchives(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusOK, `{
+		"count": 2,
+		"items": [
+			{"id": "arc1", "status": "available"},
+			{"id": "arc2", "status": "uploaded"}
+		]
+	}`)
+
+	list, err := tb.ListArchives(10, 5, "sess1")
+	if err != nil {
+		t.Fatalf("ListArchives returned error: %v", err)
+	}
+
+	if req.Method != http.MethodGet {
+		t.Errorf("method = %q, want GET", req.Method)
+	}
+	if req.Path != "/v2/project/key/archive" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/archive")
+	}
+	wantQuery := "count=5&offset=10&sessionId=sess1"
+	if req.RawQuery != wantQuery {
+		t.Errorf("query = %q, want %q", req.RawQuery, wantQuery)
+	}
+
+	if len(list) != 2 || list[0].Id != "arc1" || list[1].Id != "arc2" {
+		t.Errorf("ListArchives() = %+v, want archives arc1 and arc2", list)
+	}
+}
@@ -0,0 +1,110 @@
+package tokbox
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"golang.org/x/net/context"
+)
+
+const apiBroadcast = "/v2/project/{apiKey}/broadcast"
+
+// RTMPTarget describes a single RTMP restream destination for a broadcast.
+type RTMPTarget struct {
+	Id         string `json:"id,omitempty"`
+	ServerUrl  string `json:"serverUrl"`
+	StreamName string `json:"streamName"`
+}
+
+// HLSOptions configures the HLS output of a broadcast.
+type HLSOptions struct {
+	DVR        bool `json:"dvr,omitempty"`
+	LowLatency bool `json:"lowLatency,omitempty"`
+}
+
+// BroadcastOptions configures a call to StartBroadcast.
+// See documentation: https://tokbox.com/developer/rest/#start_broadcast
+type BroadcastOptions struct {
+	Layout      ArchiveLayout `json:"layout"`
+	MaxDuration int           `json:"maxDuration,omitempty"`
+	Resolution  string        `json:"resolution,omitempty"`
+	Outputs     struct {
+		Hls  *HLSOptions  `json:"hls,omitempty"`
+		Rtmp []RTMPTarget `json:"rtmp,omitempty"`
+	} `json:"outputs"`
+}
+
+// Broadcast represents a live streaming broadcast of an OpenTok session.
+type Broadcast struct {
+	Id         string     `json:"id"`
+	SessionId  string     `json:"sessionId"`
+	ProjectId  string     `json:"projectId"`
+	CreatedAt  int64      `json:"createdAt"`
+	UpdatedAt  int64      `json:"updatedAt"`
+	Resolution string     `json:"resolution"`
+	Status     string     `json:"status"`
+	Urls       Broadcasts `json:"broadcastUrls"`
+}
+
+// Broadcasts holds the playback URLs returned for a broadcast's outputs.
+type Broadcasts struct {
+	Hls  string       `json:"hls,omitempty"`
+	Rtmp []RTMPTarget `json:"rtmp,omitempty"`
+}
+
+// StartBroadcast starts a live streaming broadcast for an OpenTok session.
+func (t *Tokbox) StartBroadcast(sessionId string, opts BroadcastOptions, ctx ...context.Context) (*Broadcast, error) {
+	params := struct {
+		SessionId string `json:"sessionId"`
+		BroadcastOptions
+	}{
+		SessionId:        sessionId,
+		BroadcastOptions: opts,
+	}
+
+	var b Broadcast
+	if err := t.do(firstContext(ctx), http.MethodPost, apiBroadcast, params, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// StopBroadcast stops a currently running broadcast.
+func (t *Tokbox) StopBroadcast(broadcastId string, ctx ...context.Context) error {
+	return t.do(firstContext(ctx), http.MethodPost, apiBroadcast+"/"+broadcastId+"/stop", nil, nil)
+}
+
+// ListBroadcasts returns the broadcasts for the project, optionally filtered
+// by sessionId and paginated with offset/count.
+func (t *Tokbox) ListBroadcasts(offset, count int, sessionId string, ctx ...context.Context) ([]*Broadcast, error) {
+	path := apiBroadcast
+
+	q := url.Values{}
+	if offset > 0 {
+		q.Add("offset", strconv.Itoa(offset))
+	}
+	if count > 0 {
+		q.Add("count", strconv.Itoa(count))
+	}
+	if len(sessionId) > 0 {
+		q.Add("sessionId", sessionId)
+	}
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	var list struct {
+		Items []*Broadcast `json:"items"`
+		Count int          `json:"count"`
+	}
+	if err := t.do(firstContext(ctx), http.MethodGet, path, nil, &list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// UpdateBroadcastLayout changes the layout type and style of a broadcast.
+func (t *Tokbox) UpdateBroadcastLayout(broadcastId string, layout ArchiveLayout, ctx ...context.Context) error {
+	return t.do(firstContext(ctx), http.MethodPut, apiBroadcast+"/"+broadcastId+"/layout", layout, nil)
+}
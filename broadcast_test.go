@@ -0,0 +1,115 @@
+package tokbox
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestStartBroadcast(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusOK, `{
+		"id": "bcast1",
+		"sessionId": "sess1",
+		"status": "started",
+		"broadcastUrls": {"hls": "https://example.com/out.m3u8"}
+	}`)
+
+	opts := BroadcastOptions{
+		Layout:      ArchiveLayout{Type: BestFit},
+		MaxDuration: 3600,
+		Resolution:  "1280x720",
+	}
+	opts.Outputs.Hls = &HLSOptions{DVR: true, LowLatency: false}
+	opts.Outputs.Rtmp = []RTMPTarget{
+		{ServerUrl: "rtmp://example.com/live", StreamName: "mystream"},
+	}
+
+	b, err := tb.StartBroadcast("sess1", opts)
+	if err != nil {
+		t.Fatalf("StartBroadcast returned error: %v", err)
+	}
+
+	if req.Method != http.MethodPost {
+		t.Errorf("method = %q, want POST", req.Method)
+	}
+	if req.Path != "/v2/project/key/broadcast" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/broadcast")
+	}
+
+	assertJSONBody(t, req, map[string]interface{}{
+		"sessionId":   "sess1",
+		"layout":      map[string]interface{}{"type": "bestFit"},
+		"maxDuration": float64(3600),
+		"resolution":  "1280x720",
+		"outputs": map[string]interface{}{
+			"hls": map[string]interface{}{"dvr": true},
+			"rtmp": []interface{}{
+				map[string]interface{}{"serverUrl": "rtmp://example.com/live", "streamName": "mystream"},
+			},
+		},
+	})
+
+	if b.Id != "bcast1" || b.Status != "started" || b.Urls.Hls != "https://example.com/out.m3u8" {
+		t.Errorf("StartBroadcast() = %+v, want id=bcast1 status=started Urls.Hls=https://example.com/out.m3u8", b)
+	}
+}
+
+func TestStopBroadcast(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusOK)
+
+	if err := tb.StopBroadcast("bcast1"); err != nil {
+		t.Fatalf("StopBroadcast returned error: %v", err)
+	}
+
+	if req.Method != http.MethodPost {
+		t.Errorf("method = %q, want POST", req.Method)
+	}
+	if req.Path != "/v2/project/key/broadcast/bcast1/stop" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/broadcast/bcast1/stop")
+	}
+}
+
+func TestListBroadcasts(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusOK, `{
+		"count": 2,
+		"items": [
+			{"id": "bcast1", "status": "started"},
+			{"id": "bcast2", "status": "stopped"}
+		]
+	}`)
+
+	list, err := tb.ListBroadcasts(10, 5, "sess1")
+	if err != nil {
+		t.Fatalf("ListBroadcasts returned error: %v", err)
+	}
+
+	if req.Method != http.MethodGet {
+		t.Errorf("method = %q, want GET", req.Method)
+	}
+	if req.Path != "/v2/project/key/broadcast" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/broadcast")
+	}
+	wantQuery := "count=5&offset=10&sessionId=sess1"
+	if req.RawQuery != wantQuery {
+		t.Errorf("query = %q, want %q", req.RawQuery, wantQuery)
+	}
+
+	if len(list) != 2 || list[0].Id != "bcast1" || list[1].Id != "bcast2" {
+		t.Errorf("ListBroadcasts() = %+v, want broadcasts bcast1 and bcast2", list)
+	}
+}
+
+func TestUpdateBroadcastLayout(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusOK)
+
+	if err := tb.UpdateBroadcastLayout("bcast1", ArchiveLayout{Type: Pip}); err != nil {
+		t.Fatalf("UpdateBroadcastLayout returned error: %v", err)
+	}
+
+	if req.Method != http.MethodPut {
+		t.Errorf("method = %q, want PUT", req.Method)
+	}
+	if req.Path != "/v2/project/key/broadcast/bcast1/layout" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/broadcast/bcast1/layout")
+	}
+	assertJSONBody(t, req, map[string]interface{}{"type": "pip"})
+}
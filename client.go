@@ -0,0 +1,270 @@
+package tokbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+)
+
+// Option configures a Tokbox returned by New.
+type Option func(*Tokbox)
+
+// WithHTTPClient overrides the *http.Client used for API requests, e.g. to
+// inject instrumentation or point at a test double. The client's Transport
+// is wrapped to attach the project JWT; it does not need to set auth headers
+// itself.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(t *Tokbox) { t.httpClient = hc }
+}
+
+// WithBaseURL overrides the OpenTok API host, equivalent to setting BetaUrl.
+func WithBaseURL(url string) Option {
+	return func(t *Tokbox) { t.BetaUrl = url }
+}
+
+// APIError is returned when the OpenTok API responds with a status other than
+// the success status(es) the call expects (200 unless the call says otherwise).
+type APIError struct {
+	Code       string `json:"code,omitempty"`
+	Message    string `json:"message,omitempty"`
+	HTTPStatus int    `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("tokbox: %s (status %d)", e.Message, e.HTTPStatus)
+	}
+	return fmt.Sprintf("tokbox: request failed with status %d", e.HTTPStatus)
+}
+
+const (
+	maxRetries = 3
+	retryBase  = 200 * time.Millisecond
+	tokenTTL   = 5 * time.Minute // NB: the maximum allowed expiration time range is 5 minutes.
+	tokenSkew  = 30 * time.Second
+)
+
+var retryableMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodDelete: true,
+}
+
+// authTransport lazily mints and caches the project JWT, attaching it to
+// every outgoing request.
+type authTransport struct {
+	t    *Tokbox
+	base http.RoundTripper
+}
+
+func (rt *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := rt.t.authToken()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("X-OPENTOK-AUTH", token)
+	return rt.base.RoundTrip(req)
+}
+
+func (t *Tokbox) httpClientFor() *http.Client {
+	hc := t.httpClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	base := hc.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	wrapped := *hc
+	wrapped.Transport = &authTransport{t: t, base: base}
+	return &wrapped
+}
+
+// authToken returns the cached project JWT, minting a new one if the cached
+// token is within tokenSkew of expiring.
+func (t *Tokbox) authToken() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && time.Now().UTC().Before(t.tokenExpiresAt.Add(-tokenSkew)) {
+		return t.token, nil
+	}
+
+	token, expiresAt, err := t.mintJWT()
+	if err != nil {
+		return "", err
+	}
+
+	t.token = token
+	t.tokenExpiresAt = expiresAt
+	return token, nil
+}
+
+func (t *Tokbox) mintJWT() (string, time.Time, error) {
+	type TokboxClaims struct {
+		Ist string `json:"ist,omitempty"`
+		jwt.StandardClaims
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(tokenTTL)
+
+	claims := TokboxClaims{
+		"project",
+		jwt.StandardClaims{
+			Issuer:    t.apiKey,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+			Id:        uuid.New().String(),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(t.partnerSecret))
+	return signed, expiresAt, err
+}
+
+// endpoint builds the fully-qualified URL for path, which may contain an
+// "{apiKey}" placeholder, honoring BetaUrl when set.
+func (t *Tokbox) endpoint(path string) string {
+	base := apiHost
+	if t.BetaUrl != "" {
+		base = t.BetaUrl
+	}
+	return strings.ReplaceAll(base+path, "{apiKey}", t.apiKey)
+}
+
+// firstContext returns ctx[0], or nil if ctx is empty. It exists because
+// every public method accepts ctx as a trailing variadic argument so that
+// it can stay optional (see NewSession).
+func firstContext(ctx []context.Context) context.Context {
+	if len(ctx) > 0 {
+		return ctx[0]
+	}
+	return nil
+}
+
+// send issues an HTTP request against path and returns the raw response.
+// GET and DELETE requests are retried with exponential backoff and jitter on
+// 5xx and 429 responses, honoring Retry-After.
+func (t *Tokbox) send(ctx context.Context, method, path, contentType string, body []byte) (*http.Response, error) {
+	endpoint := t.endpoint(path)
+	hc := t.httpClientFor()
+
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequest(method, endpoint, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		if ctx != nil {
+			req = req.WithContext(ctx)
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		req.Header.Set("Accept", "application/json")
+
+		res, err := hc.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if !retryableMethods[method] || attempt >= maxRetries || !shouldRetry(res.StatusCode) {
+			return res, nil
+		}
+
+		wait := retryDelay(res, attempt)
+		res.Body.Close()
+		time.Sleep(wait)
+	}
+}
+
+func shouldRetry(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+func retryDelay(res *http.Response, attempt int) time.Duration {
+	if ra := res.Header.Get("Retry-After"); ra != "" {
+		if secs, err := strconv.Atoi(ra); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	backoff := retryBase * time.Duration(int64(1)<<uint(attempt))
+	return backoff + time.Duration(rand.Int63n(int64(backoff)))
+}
+
+// do sends a JSON request to path (which may contain an "{apiKey}"
+// placeholder) and decodes a JSON response into out. If body is non-nil it
+// is marshalled as the request payload. The response is considered
+// successful if its status matches one of successStatuses (200 if none are
+// given); otherwise an *APIError is returned.
+func (t *Tokbox) do(ctx context.Context, method, path string, body, out interface{}, successStatuses ...int) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	contentType := ""
+	if payload != nil {
+		contentType = "application/json"
+	}
+
+	res, err := t.send(ctx, method, path, contentType, payload)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return decodeResponse(res, out, successStatuses...)
+}
+
+// doForm sends a form-encoded request, used only by NewSession which
+// predates the JSON API.
+func (t *Tokbox) doForm(ctx context.Context, method, path string, form url.Values, out interface{}, successStatuses ...int) error {
+	res, err := t.send(ctx, method, path, "application/x-www-form-urlencoded", []byte(form.Encode()))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return decodeResponse(res, out, successStatuses...)
+}
+
+func decodeResponse(res *http.Response, out interface{}, successStatuses ...int) error {
+	if len(successStatuses) == 0 {
+		successStatuses = []int{http.StatusOK}
+	}
+
+	for _, s := range successStatuses {
+		if res.StatusCode == s {
+			if out == nil {
+				return nil
+			}
+			return json.NewDecoder(res.Body).Decode(out)
+		}
+	}
+
+	apiErr := &APIError{HTTPStatus: res.StatusCode}
+	json.NewDecoder(res.Body).Decode(apiErr)
+	return apiErr
+}
@@ -0,0 +1,163 @@
+package tokbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	cases := []struct {
+		status int
+		want   bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusNotFound, false},
+		{http.StatusBadRequest, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+	}
+
+	for _, c := range cases {
+		if got := shouldRetry(c.status); got != c.want {
+			t.Errorf("shouldRetry(%d) = %v, want %v", c.status, got, c.want)
+		}
+	}
+}
+
+func TestRetryDelayHonorsRetryAfter(t *testing.T) {
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+
+	if got, want := retryDelay(res, 0), 2*time.Second; got != want {
+		t.Errorf("retryDelay() = %v, want %v", got, want)
+	}
+}
+
+func TestRetryDelayBacksOffWithoutRetryAfter(t *testing.T) {
+	res := &http.Response{Header: http.Header{}}
+
+	for attempt := 0; attempt < 3; attempt++ {
+		min := retryBase * time.Duration(int64(1)<<uint(attempt))
+		max := min * 2
+		if got := retryDelay(res, attempt); got < min || got >= max {
+			t.Errorf("retryDelay(attempt=%d) = %v, want in [%v, %v)", attempt, got, min, max)
+		}
+	}
+}
+
+// TestDoRetriesThenSucceeds confirms a retryable method (GET) is retried on
+// 503/429 and eventually returns the successful response.
+func TestDoRetriesThenSucceeds(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&attempts, 1)
+		switch n {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id":"abc"}`))
+		}
+	}))
+	defer srv.Close()
+
+	tb := New("key", "secret", WithBaseURL(srv.URL))
+
+	var out struct {
+		Id string `json:"id"`
+	}
+	if err := tb.do(nil, http.MethodGet, "/thing", nil, &out); err != nil {
+		t.Fatalf("do() returned error after retries: %v", err)
+	}
+	if out.Id != "abc" {
+		t.Errorf("out.Id = %q, want %q", out.Id, "abc")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("server saw %d attempts, want 3", got)
+	}
+}
+
+// TestDoDoesNotRetryNonIdempotentMethods confirms a POST is not retried even
+// on a retryable status code.
+func TestDoDoesNotRetryNonIdempotentMethods(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	tb := New("key", "secret", WithBaseURL(srv.URL))
+
+	err := tb.do(nil, http.MethodPost, "/thing", nil, nil)
+	if err == nil {
+		t.Fatal("expected an error from a 503 response")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("server saw %d attempts, want 1 (no retries for POST)", got)
+	}
+}
+
+// TestDoReturnsAPIError confirms a non-2xx response is decoded into APIError.
+func TestDoReturnsAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"not_found","message":"no such archive"}`))
+	}))
+	defer srv.Close()
+
+	tb := New("key", "secret", WithBaseURL(srv.URL))
+
+	err := tb.do(nil, http.MethodGet, "/archive/missing", nil, nil)
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("err = %T, want *APIError", err)
+	}
+	if apiErr.HTTPStatus != http.StatusNotFound || apiErr.Message != "no such archive" {
+		t.Errorf("apiErr = %+v, want HTTPStatus=404 Message=%q", apiErr, "no such archive")
+	}
+}
+
+func TestAuthTokenCachesUntilNearExpiry(t *testing.T) {
+	tb := New("key", "secret")
+
+	first, err := tb.authToken()
+	if err != nil {
+		t.Fatalf("authToken() error: %v", err)
+	}
+
+	second, err := tb.authToken()
+	if err != nil {
+		t.Fatalf("authToken() error: %v", err)
+	}
+	if first != second {
+		t.Errorf("authToken() minted a new token before expiry: %q != %q", first, second)
+	}
+
+	// Force the cached token to look like it's about to expire.
+	staleExpiry := time.Now().UTC().Add(tokenSkew / 2)
+	tb.mu.Lock()
+	tb.tokenExpiresAt = staleExpiry
+	tb.mu.Unlock()
+
+	if _, err := tb.authToken(); err != nil {
+		t.Fatalf("authToken() error: %v", err)
+	}
+
+	tb.mu.Lock()
+	refreshed := tb.tokenExpiresAt
+	tb.mu.Unlock()
+
+	if !refreshed.After(staleExpiry) {
+		t.Errorf("authToken() did not refresh a token within tokenSkew of expiring: tokenExpiresAt still %v", refreshed)
+	}
+}
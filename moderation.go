@@ -0,0 +1,63 @@
+package tokbox
+
+import (
+	"net/http"
+
+	"golang.org/x/net/context"
+)
+
+// SignalPayload is the message sent to a session or connection via
+// Session.Signal and Session.SignalAll.
+type SignalPayload struct {
+	Type string `json:"type,omitempty"`
+	Data string `json:"data"`
+}
+
+// ForceDisconnect forcibly disconnects connectionId from the session.
+func (s *Session) ForceDisconnect(connectionId string, ctx ...context.Context) error {
+	return s.T.ForceDisconnect(s.SessionId, connectionId, ctx...)
+}
+
+// Signal sends a signal to a single connection in the session.
+func (s *Session) Signal(connectionId string, data SignalPayload, ctx ...context.Context) error {
+	path := apiSession2 + "/" + s.SessionId + "/connection/" + connectionId + "/signal"
+	return s.T.do(firstContext(ctx), http.MethodPost, path, data, nil, http.StatusNoContent)
+}
+
+// SignalAll sends a signal to every connection in the session.
+func (s *Session) SignalAll(data SignalPayload, ctx ...context.Context) error {
+	path := apiSession2 + "/" + s.SessionId + "/signal"
+	return s.T.do(firstContext(ctx), http.MethodPost, path, data, nil, http.StatusNoContent)
+}
+
+// MuteStream forces a single stream in the session to mute its published audio.
+func (s *Session) MuteStream(streamId string, ctx ...context.Context) error {
+	path := apiSession2 + "/" + s.SessionId + "/stream/" + streamId + "/mute"
+	return s.T.do(firstContext(ctx), http.MethodPost, path, nil, nil)
+}
+
+// MuteAll forces all streams in the session, except those listed in
+// excludedStreamIds, to mute their published audio. Streams published after
+// the call also start out muted, until DisableForceMute is called.
+func (s *Session) MuteAll(excludedStreamIds []string, ctx ...context.Context) error {
+	return s.setMuteAll(true, excludedStreamIds, ctx...)
+}
+
+// DisableForceMute reverses a previous call to MuteAll, allowing clients to
+// publish audio again.
+func (s *Session) DisableForceMute(ctx ...context.Context) error {
+	return s.setMuteAll(false, nil, ctx...)
+}
+
+func (s *Session) setMuteAll(active bool, excludedStreamIds []string, ctx ...context.Context) error {
+	path := apiSession2 + "/" + s.SessionId + "/mute"
+	params := struct {
+		Active            bool     `json:"active"`
+		ExcludedStreamIds []string `json:"excludedStreamIds,omitempty"`
+	}{
+		Active:            active,
+		ExcludedStreamIds: excludedStreamIds,
+	}
+
+	return s.T.do(firstContext(ctx), http.MethodPost, path, params, nil)
+}
@@ -0,0 +1,98 @@
+package tokbox
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSessionModerationRequests(t *testing.T) {
+	cases := []struct {
+		name       string
+		call       func(s *Session) error
+		wantMethod string
+		wantPath   string
+		wantBody   map[string]interface{}
+		status     int
+	}{
+		{
+			name: "ForceDisconnect",
+			call: func(s *Session) error {
+				return s.ForceDisconnect("conn1")
+			},
+			wantMethod: http.MethodDelete,
+			wantPath:   "/v2/project/key/session/sess1/connection/conn1",
+			status:     http.StatusNoContent,
+		},
+		{
+			name: "Signal",
+			call: func(s *Session) error {
+				return s.Signal("conn1", SignalPayload{Type: "chat", Data: "hi"})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v2/project/key/session/sess1/connection/conn1/signal",
+			wantBody:   map[string]interface{}{"type": "chat", "data": "hi"},
+			status:     http.StatusNoContent,
+		},
+		{
+			name: "SignalAll",
+			call: func(s *Session) error {
+				return s.SignalAll(SignalPayload{Data: "hi all"})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v2/project/key/session/sess1/signal",
+			wantBody:   map[string]interface{}{"data": "hi all"},
+			status:     http.StatusNoContent,
+		},
+		{
+			name: "MuteStream",
+			call: func(s *Session) error {
+				return s.MuteStream("stream1")
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v2/project/key/session/sess1/stream/stream1/mute",
+			status:     http.StatusOK,
+		},
+		{
+			name: "MuteAll",
+			call: func(s *Session) error {
+				return s.MuteAll([]string{"stream1", "stream2"})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v2/project/key/session/sess1/mute",
+			wantBody: map[string]interface{}{
+				"active":            true,
+				"excludedStreamIds": []interface{}{"stream1", "stream2"},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "DisableForceMute",
+			call: func(s *Session) error {
+				return s.DisableForceMute()
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v2/project/key/session/sess1/mute",
+			wantBody:   map[string]interface{}{"active": false},
+			status:     http.StatusOK,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tb, req := captureRequest(t, c.status)
+			s := &Session{SessionId: "sess1", T: tb}
+
+			if err := c.call(s); err != nil {
+				t.Fatalf("call returned error: %v", err)
+			}
+
+			if req.Method != c.wantMethod {
+				t.Errorf("method = %q, want %q", req.Method, c.wantMethod)
+			}
+			if req.Path != c.wantPath {
+				t.Errorf("path = %q, want %q", req.Path, c.wantPath)
+			}
+			assertJSONBody(t, req, c.wantBody)
+		})
+	}
+}
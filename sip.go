@@ -0,0 +1,93 @@
+package tokbox
+
+import (
+	"net/http"
+
+	"golang.org/x/net/context"
+)
+
+const apiDial = "/v2/project/{apiKey}/dial"
+
+// SIPAuth carries the credentials used to authenticate against the SIP gateway.
+type SIPAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// SIPOptions configures a SIP interconnect dial-out. See documentation:
+// https://tokbox.com/developer/guides/sip/
+type SIPOptions struct {
+	From             string            `json:"from,omitempty"`
+	Headers          map[string]string `json:"headers,omitempty"`
+	Auth             *SIPAuth          `json:"auth,omitempty"`
+	Secure           bool              `json:"secure,omitempty"`
+	Video            bool              `json:"video,omitempty"`
+	ObserveForceMute bool              `json:"observeForceMute,omitempty"`
+}
+
+// SIPCall represents the connection created by Dial, which can later be used
+// to moderate the SIP participant.
+type SIPCall struct {
+	Id           string `json:"id"`
+	ConnectionId string `json:"connectionId"`
+	StreamId     string `json:"streamId"`
+}
+
+// Dial bridges a PSTN or SIP endpoint into an OpenTok session.
+// See documentation: https://tokbox.com/developer/guides/sip/
+func (t *Tokbox) Dial(sessionId string, token string, sipURI string, opts SIPOptions, ctx ...context.Context) (*SIPCall, error) {
+	params := struct {
+		SessionId string `json:"sessionId"`
+		Token     string `json:"token"`
+		Sip       struct {
+			Uri              string            `json:"uri"`
+			From             string            `json:"from,omitempty"`
+			Headers          map[string]string `json:"headers,omitempty"`
+			Auth             *SIPAuth          `json:"auth,omitempty"`
+			Secure           bool              `json:"secure,omitempty"`
+			Video            bool              `json:"video,omitempty"`
+			ObserveForceMute bool              `json:"observeForceMute,omitempty"`
+		} `json:"sip"`
+	}{
+		SessionId: sessionId,
+		Token:     token,
+	}
+	params.Sip.Uri = sipURI
+	params.Sip.From = opts.From
+	params.Sip.Headers = opts.Headers
+	params.Sip.Auth = opts.Auth
+	params.Sip.Secure = opts.Secure
+	params.Sip.Video = opts.Video
+	params.Sip.ObserveForceMute = opts.ObserveForceMute
+
+	var call SIPCall
+	if err := t.do(firstContext(ctx), http.MethodPost, apiDial, params, &call); err != nil {
+		return nil, err
+	}
+	return &call, nil
+}
+
+// ForceDisconnect forcibly disconnects a connection, such as the SIP connection
+// created by Dial, from a session.
+func (t *Tokbox) ForceDisconnect(sessionId, connectionId string, ctx ...context.Context) error {
+	path := apiSession2 + "/" + sessionId + "/connection/" + connectionId
+	return t.do(firstContext(ctx), http.MethodDelete, path, nil, nil, http.StatusNoContent)
+}
+
+// PlayDTMF plays DTMF digits into a session, or to a single connection within
+// a session such as the one returned by Dial.
+func (t *Tokbox) PlayDTMF(sessionId, connectionId, digits string, ctx ...context.Context) error {
+	path := apiSession2 + "/" + sessionId
+	if len(connectionId) > 0 {
+		path += "/connection/" + connectionId
+	}
+	path += "/play-dtmf"
+
+	params := struct {
+		Digits string `json:"digits"`
+	}{
+		Digits: digits,
+	}
+
+	return t.do(firstContext(ctx), http.MethodPost, path, params, nil)
+}
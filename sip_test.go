@@ -0,0 +1,106 @@
+package tokbox
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestDial(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusOK, `{
+		"id": "call1",
+		"connectionId": "conn1",
+		"streamId": "stream1"
+	}`)
+
+	opts := SIPOptions{
+		From:             "+15551234567",
+		Headers:          map[string]string{"X-Foo": "bar"},
+		Auth:             &SIPAuth{Username: "user", Password: "pass"},
+		Secure:           true,
+		Video:            true,
+		ObserveForceMute: true,
+	}
+
+	call, err := tb.Dial("sess1", "tok1", "sip:alice@example.com", opts)
+	if err != nil {
+		t.Fatalf("Dial returned error: %v", err)
+	}
+
+	if req.Method != http.MethodPost {
+		t.Errorf("method = %q, want POST", req.Method)
+	}
+	if req.Path != "/v2/project/key/dial" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/dial")
+	}
+
+	assertJSONBody(t, req, map[string]interface{}{
+		"sessionId": "sess1",
+		"token":     "tok1",
+		"sip": map[string]interface{}{
+			"uri":              "sip:alice@example.com",
+			"from":             "+15551234567",
+			"headers":          map[string]interface{}{"X-Foo": "bar"},
+			"auth":             map[string]interface{}{"username": "user", "password": "pass"},
+			"secure":           true,
+			"video":            true,
+			"observeForceMute": true,
+		},
+	})
+
+	wantCall := &SIPCall{Id: "call1", ConnectionId: "conn1", StreamId: "stream1"}
+	if *call != *wantCall {
+		t.Errorf("Dial() = %+v, want %+v", call, wantCall)
+	}
+}
+
+func TestForceDisconnect(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusNoContent)
+
+	if err := tb.ForceDisconnect("sess1", "conn1"); err != nil {
+		t.Fatalf("ForceDisconnect returned error: %v", err)
+	}
+
+	if req.Method != http.MethodDelete {
+		t.Errorf("method = %q, want DELETE", req.Method)
+	}
+	if req.Path != "/v2/project/key/session/sess1/connection/conn1" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/session/sess1/connection/conn1")
+	}
+}
+
+func TestPlayDTMF(t *testing.T) {
+	cases := []struct {
+		name         string
+		connectionId string
+		wantPath     string
+	}{
+		{
+			name:         "WholeSession",
+			connectionId: "",
+			wantPath:     "/v2/project/key/session/sess1/play-dtmf",
+		},
+		{
+			name:         "SingleConnection",
+			connectionId: "conn1",
+			wantPath:     "/v2/project/key/session/sess1/connection/conn1/play-dtmf",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tb, req := captureRequest(t, http.StatusOK)
+
+			if err := tb.PlayDTMF("sess1", c.connectionId, "1713"); err != nil {
+				t.Fatalf("PlayDTMF returned error: %v", err)
+			}
+
+			if req.Method != http.MethodPost {
+				t.Errorf("method = %q, want POST", req.Method)
+			}
+			if req.Path != c.wantPath {
+				t.Errorf("path = %q, want %q", req.Path, c.wantPath)
+			}
+			assertJSONBody(t, req, map[string]interface{}{"digits": "1713"})
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package tokbox
+
+import (
+	"net/http"
+
+	"golang.org/x/net/context"
+)
+
+const apiArchiveStorage = "/v2/project/{apiKey}/archive/storage"
+
+// StorageTarget is implemented by the upload targets that OpenTok can write
+// composed archives to in place of the default OpenTok CDN.
+type StorageTarget interface {
+	storageType() string
+}
+
+// AmazonS3Config configures an Amazon S3 bucket as an archive storage target.
+type AmazonS3Config struct {
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+	Bucket    string `json:"bucket"`
+	Endpoint  string `json:"endpoint,omitempty"`
+}
+
+func (AmazonS3Config) storageType() string { return "s3" }
+
+// AzureConfig configures an Azure Blob Storage container as an archive
+// storage target.
+type AzureConfig struct {
+	AccountName string `json:"accountName"`
+	AccountKey  string `json:"accountKey"`
+	Container   string `json:"container"`
+	Domain      string `json:"domain,omitempty"`
+}
+
+func (AzureConfig) storageType() string { return "azure" }
+
+// SetArchiveStorage sets the default storage target that composed archives
+// for the project are uploaded to. If fallback is true, OpenTok falls back
+// to its own CDN when the upload to target fails.
+func (t *Tokbox) SetArchiveStorage(target StorageTarget, fallback bool, ctx ...context.Context) error {
+	params := struct {
+		Type     string        `json:"type"`
+		Config   StorageTarget `json:"config"`
+		Fallback string        `json:"fallback,omitempty"`
+	}{
+		Type:   target.storageType(),
+		Config: target,
+	}
+	if fallback {
+		params.Fallback = "opentok"
+	} else {
+		params.Fallback = "none"
+	}
+
+	return t.do(firstContext(ctx), http.MethodPut, apiArchiveStorage, params, nil)
+}
+
+// DeleteArchiveStorage removes the project's archive storage target,
+// reverting composed archives to the default OpenTok CDN.
+func (t *Tokbox) DeleteArchiveStorage(ctx ...context.Context) error {
+	return t.do(firstContext(ctx), http.MethodDelete, apiArchiveStorage, nil, nil, http.StatusNoContent)
+}
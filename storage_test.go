@@ -0,0 +1,103 @@
+package tokbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSetArchiveStorage(t *testing.T) {
+	cases := []struct {
+		name         string
+		target       StorageTarget
+		fallback     bool
+		wantType     string
+		wantConfig   map[string]interface{}
+		wantFallback string
+	}{
+		{
+			name: "S3WithFallback",
+			target: AmazonS3Config{
+				AccessKey: "ak",
+				SecretKey: "sk",
+				Bucket:    "my-bucket",
+			},
+			fallback: true,
+			wantType: "s3",
+			wantConfig: map[string]interface{}{
+				"accessKey": "ak",
+				"secretKey": "sk",
+				"bucket":    "my-bucket",
+			},
+			wantFallback: "opentok",
+		},
+		{
+			name: "AzureWithoutFallback",
+			target: AzureConfig{
+				AccountName: "acct",
+				AccountKey:  "key",
+				Container:   "videos",
+			},
+			fallback: false,
+			wantType: "azure",
+			wantConfig: map[string]interface{}{
+				"accountName": "acct",
+				"accountKey":  "key",
+				"container":   "videos",
+			},
+			wantFallback: "none",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tb, req := captureRequest(t, http.StatusOK)
+			if err := tb.SetArchiveStorage(c.target, c.fallback); err != nil {
+				t.Fatalf("SetArchiveStorage returned error: %v", err)
+			}
+
+			if req.Method != http.MethodPut {
+				t.Errorf("method = %q, want PUT", req.Method)
+			}
+			if req.Path != "/v2/project/key/archive/storage" {
+				t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/archive/storage")
+			}
+
+			var envelope struct {
+				Type     string                 `json:"type"`
+				Config   map[string]interface{} `json:"config"`
+				Fallback string                 `json:"fallback"`
+			}
+			if err := json.Unmarshal(req.Body, &envelope); err != nil {
+				t.Fatalf("body did not decode as JSON: %v (body=%s)", err, req.Body)
+			}
+
+			if envelope.Type != c.wantType {
+				t.Errorf("type = %q, want %q", envelope.Type, c.wantType)
+			}
+			if envelope.Fallback != c.wantFallback {
+				t.Errorf("fallback = %q, want %q", envelope.Fallback, c.wantFallback)
+			}
+			for k, want := range c.wantConfig {
+				if got := envelope.Config[k]; got != want {
+					t.Errorf("config[%q] = %v, want %v", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteArchiveStorage(t *testing.T) {
+	tb, req := captureRequest(t, http.StatusNoContent)
+
+	if err := tb.DeleteArchiveStorage(); err != nil {
+		t.Fatalf("DeleteArchiveStorage returned error: %v", err)
+	}
+
+	if req.Method != http.MethodDelete {
+		t.Errorf("method = %q, want DELETE", req.Method)
+	}
+	if req.Path != "/v2/project/key/archive/storage" {
+		t.Errorf("path = %q, want %q", req.Path, "/v2/project/key/archive/storage")
+	}
+}
@@ -6,28 +6,24 @@ import (
 	"net/url"
 
 	"encoding/base64"
-	"encoding/json"
 
 	"crypto/hmac"
 	"crypto/sha1"
 
 	"fmt"
 	"math/rand"
-	"strings"
 	"time"
 
 	"sync"
 
 	"golang.org/x/net/context"
-
-	jwt "github.com/dgrijalva/jwt-go"
-	"github.com/google/uuid"
 )
 
 const (
-	apiHost    = "https://api.opentok.com"
-	apiSession = "/session/create"
-	apiArchive = "/v2/project/{apiKey}/archive"
+	apiHost     = "https://api.opentok.com"
+	apiSession  = "/session/create"
+	apiArchive  = "/v2/project/{apiKey}/archive"
+	apiSession2 = "/v2/project/{apiKey}/session"
 )
 
 const (
@@ -100,6 +96,12 @@ type Tokbox struct {
 	apiKey        string
 	partnerSecret string
 	BetaUrl       string //Endpoint for Beta Programs
+
+	httpClient *http.Client
+
+	mu             sync.Mutex
+	token          string
+	tokenExpiresAt time.Time
 }
 
 type Session struct {
@@ -118,28 +120,12 @@ type ArchiveLayout struct {
 	ScreenshareType LayoutType `json:"screenshareType,omitempty"`
 }
 
-func New(apikey, partnerSecret string) *Tokbox {
-	return &Tokbox{apikey, partnerSecret, ""}
-}
-
-func (t *Tokbox) jwtToken() (string, error) {
-
-	type TokboxClaims struct {
-		Ist string `json:"ist,omitempty"`
-		jwt.StandardClaims
+func New(apikey, partnerSecret string, opts ...Option) *Tokbox {
+	t := &Tokbox{apiKey: apikey, partnerSecret: partnerSecret}
+	for _, opt := range opts {
+		opt(t)
 	}
-
-	claims := TokboxClaims{
-		"project",
-		jwt.StandardClaims{
-			Issuer:    t.apiKey,
-			IssuedAt:  time.Now().UTC().Unix(),
-			ExpiresAt: time.Now().UTC().Unix() + (2 * 24 * 60 * 60), // 2 hours; //NB: The maximum allowed expiration time range is 5 minutes.
-			Id:        uuid.New().String(),
-		},
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(t.partnerSecret))
+	return t
 }
 
 // Creates a new tokbox session or returns an error.
@@ -160,41 +146,8 @@ func (t *Tokbox) NewSession(location string, mm MediaMode, archiveMode ArchiveMo
 
 	params.Add("p2p.preference", string(mm))
 
-	var endpoint string
-	if t.BetaUrl == "" {
-		endpoint = apiHost
-	} else {
-		endpoint = t.BetaUrl
-	}
-	req, err := http.NewRequest("POST", endpoint+apiSession, strings.NewReader(params.Encode()))
-	if err != nil {
-		return nil, err
-	}
-
-	//Create jwt token
-	jwt, err := t.jwtToken()
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("X-OPENTOK-AUTH", jwt)
-
-	if len(ctx) == 0 {
-		ctx = append(ctx, nil)
-	}
-	res, err := client(ctx[0]).Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("Tokbox returns error code: %v", res.StatusCode)
-	}
-
 	var s []Session
-	if err = json.NewDecoder(res.Body).Decode(&s); err != nil {
+	if err := t.doForm(firstContext(ctx), http.MethodPost, apiSession, params, &s); err != nil {
 		return nil, err
 	}
 
@@ -207,64 +160,6 @@ func (t *Tokbox) NewSession(location string, mm MediaMode, archiveMode ArchiveMo
 	return &o, nil
 }
 
-// Customizing the video layout for composed archives
-// See documentation: https://tokbox.com/developer/guides/archiving/layout-control.html
-func (t *Tokbox) StartArchive(sessionId string, name string, outputMode OutputMode, layout ArchiveLayout, ctx ...context.Context) error {
-	var endpoint string
-
-	if t.BetaUrl == "" {
-		endpoint = apiHost + apiArchive
-	} else {
-		endpoint = t.BetaUrl + apiArchive
-	}
-
-	endpoint = strings.ReplaceAll(endpoint, "{apiKey}", t.apiKey)
-
-	params := struct {
-		SessionId  string        `json:"sessionId"`
-		Layout     ArchiveLayout `json:"layout"`
-		Name       string        `json:"name"`
-		OutputMode OutputMode    `json:"outputMode"`
-	}{
-		SessionId:  sessionId,
-		Layout:     layout,
-		Name:       name,
-		OutputMode: outputMode,
-	}
-
-	data, err := json.Marshal(params)
-
-	if err != nil {
-		return err
-	}
-
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewBuffer(data))
-
-	jwt, err := t.jwtToken()
-	if err != nil {
-		return err
-	}
-
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("X-OPENTOK-AUTH", jwt)
-
-	if len(ctx) == 0 {
-		ctx = append(ctx, nil)
-	}
-	res, err := client(ctx[0]).Do(req)
-	if err != nil {
-		return err
-	}
-
-	defer res.Body.Close()
-
-	if res.StatusCode != 200 {
-		return fmt.Errorf("Tokbox returns error code: %v", res.StatusCode)
-	}
-
-	return nil
-}
-
 func (s *Session) Token(role Role, connectionData string, expiration int64) (string, error) {
 	now := time.Now().UTC().Unix()
 
@@ -0,0 +1,67 @@
+package tokbox
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// capturedRequest records what a captureRequest test server observed.
+type capturedRequest struct {
+	Method   string
+	Path     string
+	RawQuery string
+	Body     []byte
+}
+
+// captureRequest starts an httptest.Server that records the single request
+// made to it, responds with status (and responseBody[0], if given, written
+// as the raw response body), and returns a *Tokbox pointed at the server
+// alongside the *capturedRequest it will populate.
+func captureRequest(t *testing.T, status int, responseBody ...string) (*Tokbox, *capturedRequest) {
+	t.Helper()
+
+	captured := &capturedRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured.Method = r.Method
+		captured.Path = r.URL.Path
+		captured.RawQuery = r.URL.RawQuery
+		captured.Body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		if len(responseBody) > 0 {
+			io.WriteString(w, responseBody[0])
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return New("key", "secret", WithBaseURL(srv.URL)), captured
+}
+
+// assertJSONBody checks that every key in want is present in req's body with
+// an equal (JSON-compared) value. A nil want is a no-op.
+func assertJSONBody(t *testing.T, req *capturedRequest, want map[string]interface{}) {
+	t.Helper()
+
+	if want == nil {
+		return
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		t.Fatalf("body did not decode as JSON: %v (body=%s)", err, req.Body)
+	}
+	for k, wantVal := range want {
+		gotVal, ok := body[k]
+		if !ok {
+			t.Errorf("body missing key %q, got %v", k, body)
+			continue
+		}
+		gotJSON, _ := json.Marshal(gotVal)
+		wantJSON, _ := json.Marshal(wantVal)
+		if string(gotJSON) != string(wantJSON) {
+			t.Errorf("body[%q] = %s, want %s", k, gotJSON, wantJSON)
+		}
+	}
+}